@@ -1,28 +1,19 @@
 // Package stackadler32 provides an optimized implementation of the Adler-32 checksum algorithm.
 //
-// This implementation uses Arm NEON instructions via a C shared library for superior performance.
-// The core Update function is implemented in C and optimized with SIMD instructions,
-// while the Go code handles the API and interface.
+// On arm64/arm with cgo enabled, it uses Arm NEON instructions via a C shared
+// library for superior performance; the core Update function is implemented
+// in C and optimized with SIMD instructions. On all other targets (including
+// GOARCH=amd64 and CGO_ENABLED=0 builds), it falls back to a pure-Go
+// implementation so the package remains usable for cross-compilation and CI.
 //
-// Performance benchmarks on Arm Neoverse N1 processors show significant improvements
-// over the standard library implementation:
+// Performance benchmarks on Arm Neoverse N1 processors show significant
+// improvements over the standard library implementation when the NEON path
+// is active:
 //   - Small data (10KB): ~18.6% faster
 //   - Medium data (1MB): ~25.3% faster
 //   - Large data (10MB): ~25.8% faster
 package stackadler32
 
-/*
-#cgo LDFLAGS: -L. -ladler32
-#include <stdlib.h>
-#include <stdint.h>
-
-extern void adler32_update(uint32_t *s1, uint32_t *s2, const unsigned char *buf, size_t len);
-*/
-import "C"
-import (
-	"unsafe"
-)
-
 // prime is the largest prime number less than 2^16, used in the Adler-32 algorithm.
 const prime uint32 = 65521
 
@@ -46,36 +37,6 @@ func NewDigest() Digest {
 	}
 }
 
-// Update returns a new derived Adler-32 digest with the input data incorporated.
-// This implementation calls the optimized C function via cgo, which uses
-// Arm NEON instructions for improved performance.
-//
-// The function processes the data in blocks to minimize expensive modulo operations
-// and uses SIMD instructions to process multiple bytes in parallel.
-func (d Digest) Update(buf []byte) Digest {
-	r := d
-	if !r.initialized {
-		r = NewDigest()
-	}
-
-	if len(buf) > 0 {
-		s1 := C.uint32_t(r.s1)
-		s2 := C.uint32_t(r.s2)
-		
-		// Convert Go byte slice to C byte array
-		cBuf := (*C.uchar)(unsafe.Pointer(&buf[0]))
-		cLen := C.size_t(len(buf))
-		
-		// Call the C implementation
-		C.adler32_update(&s1, &s2, cBuf, cLen)
-		
-		r.s1 = uint32(s1)
-		r.s2 = uint32(s2)
-	}
-	
-	return r
-}
-
 // Sum32 returns the current Adler-32 checksum as a uint32.
 // The checksum is computed by combining the two components (s1 and s2)
 // where s2 forms the high 16 bits and s1 forms the low 16 bits.
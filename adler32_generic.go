@@ -0,0 +1,38 @@
+//go:build !((arm64 || arm) && cgo)
+
+package stackadler32
+
+// nmax is the largest number of bytes that can be summed into s1 and s2
+// without overflowing their uint32 range before a modulo reduction is
+// required, mirroring the standard library's hash/adler32 implementation.
+const nmax = 5552
+
+// Update returns a new derived Adler-32 digest with the input data incorporated.
+// This is a pure-Go fallback used on targets without NEON/cgo support
+// (e.g. GOARCH=amd64 or CGO_ENABLED=0 builds). It accumulates s1 and s2 over
+// chunks of up to nmax bytes, taking the modulo between chunks to keep the
+// running totals from overflowing.
+func (d Digest) Update(buf []byte) Digest {
+	r := d
+	if !r.initialized {
+		r = NewDigest()
+	}
+
+	s1, s2 := r.s1, r.s2
+	for len(buf) > 0 {
+		chunk := buf
+		if len(chunk) > nmax {
+			chunk = chunk[:nmax]
+		}
+		for _, b := range chunk {
+			s1 += uint32(b)
+			s2 += s1
+		}
+		s1 %= prime
+		s2 %= prime
+		buf = buf[len(chunk):]
+	}
+
+	r.s1, r.s2 = s1, s2
+	return r
+}
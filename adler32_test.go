@@ -0,0 +1,46 @@
+package stackadler32
+
+import (
+	"hash/adler32"
+	"testing"
+)
+
+var checksumTests = []string{
+	"",
+	"a",
+	"ab",
+	"abc",
+	"Wikipedia",
+	"The quick brown fox jumps over the lazy dog",
+}
+
+// TestChecksum verifies that Checksum (and therefore whichever Update
+// implementation is active for this build) agrees with the standard
+// library's hash/adler32 across both the NEON and pure-Go code paths.
+func TestChecksum(t *testing.T) {
+	for _, s := range checksumTests {
+		got := Checksum([]byte(s))
+		want := adler32.Checksum([]byte(s))
+		if got != want {
+			t.Errorf("Checksum(%q) = %#x, want %#x", s, got, want)
+		}
+	}
+}
+
+// TestUpdateIncremental verifies that splitting input across multiple
+// Update calls produces the same result as a single call, for both the
+// NEON and pure-Go Update implementations.
+func TestUpdateIncremental(t *testing.T) {
+	for _, s := range checksumTests {
+		buf := []byte(s)
+		if len(buf) < 2 {
+			continue
+		}
+		mid := len(buf) / 2
+		got := NewDigest().Update(buf[:mid]).Update(buf[mid:]).Sum32()
+		want := adler32.Checksum(buf)
+		if got != want {
+			t.Errorf("split Update(%q) = %#x, want %#x", s, got, want)
+		}
+	}
+}
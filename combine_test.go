@@ -0,0 +1,92 @@
+package stackadler32
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+// TestCombine verifies that Combine/CombineSum, given only the digests of
+// two adjacent slices of a buffer, reproduce the checksum of the whole
+// buffer.
+func TestCombine(t *testing.T) {
+	data := make([]byte, 5000)
+	rand.New(rand.NewSource(2)).Read(data)
+
+	for _, split := range []int{0, 1, 7, 2500, 4999, 5000} {
+		a := NewDigest().Update(data[:split])
+		b := NewDigest().Update(data[split:])
+
+		want := Checksum(data)
+		if got := Combine(a, b, int64(len(data)-split)).Sum32(); got != want {
+			t.Errorf("split %d: Combine(...).Sum32() = %#x, want %#x", split, got, want)
+		}
+		if got := CombineSum(a.Sum32(), b.Sum32(), int64(len(data)-split)); got != want {
+			t.Errorf("split %d: CombineSum(...) = %#x, want %#x", split, got, want)
+		}
+	}
+}
+
+// TestCombineZeroValue verifies that Combine treats a zero-value Digest{}
+// as an empty prefix, the same way Digest.Update and Digest.Sum32 treat an
+// uninitialized Digest as equivalent to NewDigest().
+func TestCombineZeroValue(t *testing.T) {
+	data := []byte("hello")
+	b := NewDigest().Update(data)
+
+	want := Checksum(data)
+	if got := Combine(Digest{}, b, int64(len(data))).Sum32(); got != want {
+		t.Errorf("Combine(Digest{}, b, ...).Sum32() = %#x, want %#x", got, want)
+	}
+	if got := Combine(b, Digest{}, 0).Sum32(); got != want {
+		t.Errorf("Combine(b, Digest{}, 0).Sum32() = %#x, want %#x", got, want)
+	}
+}
+
+// TestCombineNegativeLen verifies that a negative lenB, which cannot
+// correspond to a real stream length, yields the zlib-style sentinel
+// checksum 0xffffffff instead of a silently bogus digest.
+func TestCombineNegativeLen(t *testing.T) {
+	a := NewDigest().Update([]byte("hello"))
+	b := NewDigest().Update([]byte("world"))
+
+	const want = 0xffffffff
+	if got := Combine(a, b, -5).Sum32(); got != want {
+		t.Errorf("Combine(a, b, -5).Sum32() = %#x, want %#x", got, want)
+	}
+	if got := CombineSum(a.Sum32(), b.Sum32(), -5); got != want {
+		t.Errorf("CombineSum(a, b, -5) = %#x, want %#x", got, want)
+	}
+}
+
+// TestChecksumParallel verifies that ChecksumParallel agrees with Checksum
+// across a range of shard counts.
+func TestChecksumParallel(t *testing.T) {
+	data := make([]byte, 10000)
+	rand.New(rand.NewSource(3)).Read(data)
+
+	want := Checksum(data)
+	for _, shards := range []int{0, 1, 2, 3, 7, 64} {
+		if got := ChecksumParallel(data, shards); got != want {
+			t.Errorf("shards=%d: ChecksumParallel = %#x, want %#x", shards, got, want)
+		}
+	}
+
+	if got := ChecksumParallel(nil, 4); got != Checksum(nil) {
+		t.Errorf("empty buffer: ChecksumParallel = %#x, want %#x", got, Checksum(nil))
+	}
+}
+
+func BenchmarkChecksumParallel(b *testing.B) {
+	data := make([]byte, 16<<20)
+	rand.New(rand.NewSource(4)).Read(data)
+
+	for _, shards := range []int{1, 2, 4, 8, 16} {
+		b.Run(fmt.Sprintf("shards=%d", shards), func(b *testing.B) {
+			b.SetBytes(int64(len(data)))
+			for i := 0; i < b.N; i++ {
+				ChecksumParallel(data, shards)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package stackadler32
+
+import "hash"
+
+// digest adapts Digest to the standard library's hash.Hash32 interface.
+// Unlike Digest, it is a pointer type that accumulates state across calls
+// to Write, matching the behavior expected by io.Writer-based consumers
+// such as io.MultiWriter and compress/zlib.
+type digest struct {
+	d Digest
+}
+
+// New returns a new hash.Hash32 computing the Adler-32 checksum, backed by
+// this package's Update (NEON-accelerated on arm64/arm with cgo, pure Go on
+// other targets). It allows the accelerated
+// implementation to be used anywhere the standard library's hash.Hash32
+// is expected.
+func New() hash.Hash32 {
+	return &digest{d: NewDigest()}
+}
+
+// Write adds more data to the running checksum. It never returns an error.
+func (d *digest) Write(p []byte) (int, error) {
+	d.d = d.d.Update(p)
+	return len(p), nil
+}
+
+// Sum appends the current checksum to b and returns the resulting slice.
+// The checksum is encoded in most-significant-byte-first order, per RFC 1950.
+func (d *digest) Sum(b []byte) []byte {
+	s := d.d.Sum32()
+	return append(b, byte(s>>24), byte(s>>16), byte(s>>8), byte(s))
+}
+
+// Reset returns the digest to its initial state.
+func (d *digest) Reset() {
+	d.d = NewDigest()
+}
+
+// Size returns the number of bytes Sum will append: 4.
+func (d *digest) Size() int { return 4 }
+
+// BlockSize returns the digest's block size: 1.
+func (d *digest) BlockSize() int { return 1 }
+
+// Sum32 returns the current Adler-32 checksum as a uint32.
+func (d *digest) Sum32() uint32 {
+	return d.d.Sum32()
+}
@@ -0,0 +1,69 @@
+package stackadler32
+
+import (
+	"bytes"
+	"hash/adler32"
+	"io"
+	"testing"
+)
+
+var hash32Tests = []string{
+	"",
+	"a",
+	"ab",
+	"abc",
+	"Wikipedia",
+	"The quick brown fox jumps over the lazy dog",
+}
+
+// TestHash32 verifies that New()'s hash.Hash32 wrapper agrees with
+// hash/adler32, including the byte order of Sum's output.
+func TestHash32(t *testing.T) {
+	for _, s := range hash32Tests {
+		h := New()
+		if _, err := h.Write([]byte(s)); err != nil {
+			t.Fatalf("Write(%q): %v", s, err)
+		}
+
+		if got, want := h.Sum32(), adler32.Checksum([]byte(s)); got != want {
+			t.Errorf("Sum32(%q) = %#x, want %#x", s, got, want)
+		}
+
+		got := h.Sum(nil)
+		want := make([]byte, 0, 4)
+		want = append(want, byte(h.Sum32()>>24), byte(h.Sum32()>>16), byte(h.Sum32()>>8), byte(h.Sum32()))
+		if !bytes.Equal(got, want) {
+			t.Errorf("Sum(%q) = %x, want %x", s, got, want)
+		}
+
+		if got, want := h.Size(), 4; got != want {
+			t.Errorf("Size() = %d, want %d", got, want)
+		}
+		if got, want := h.BlockSize(), 1; got != want {
+			t.Errorf("BlockSize() = %d, want %d", got, want)
+		}
+	}
+}
+
+// TestHash32MultiWriter verifies that New()'s wrapper accumulates state
+// correctly across multiple writes, including through io.MultiWriter.
+func TestHash32MultiWriter(t *testing.T) {
+	const s = "The quick brown fox jumps over the lazy dog"
+
+	h := New()
+	mw := io.MultiWriter(h)
+	for _, chunk := range []string{s[:10], s[10:25], s[25:]} {
+		if _, err := mw.Write([]byte(chunk)); err != nil {
+			t.Fatalf("Write(%q): %v", chunk, err)
+		}
+	}
+
+	if got, want := h.Sum32(), adler32.Checksum([]byte(s)); got != want {
+		t.Errorf("Sum32 after split writes = %#x, want %#x", got, want)
+	}
+
+	h.Reset()
+	if got, want := h.Sum32(), NewDigest().Sum32(); got != want {
+		t.Errorf("Sum32 after Reset = %#x, want %#x", got, want)
+	}
+}
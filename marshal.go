@@ -0,0 +1,52 @@
+package stackadler32
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// marshaledSize is the length of the encoding produced by MarshalBinary:
+// the magic prefix plus the big-endian combined checksum.
+const marshaledSize = len(magic) + 4
+
+// magic identifies the encoding produced by MarshalBinary. It matches the
+// layout used by the standard library's hash/adler32, so saved state is
+// interchangeable between the two packages.
+const magic = "adl\x01"
+
+// MarshalBinary returns a binary representation of d's state, so a running
+// checksum can be saved and later resumed with UnmarshalBinary.
+func (d *Digest) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 0, marshaledSize)
+	b = append(b, magic...)
+	b = binary.BigEndian.AppendUint32(b, d.Sum32())
+	return b, nil
+}
+
+// UnmarshalBinary restores d's state from a representation produced by
+// MarshalBinary.
+func (d *Digest) UnmarshalBinary(b []byte) error {
+	if len(b) < len(magic) || string(b[:len(magic)]) != magic {
+		return errors.New("stackadler32: invalid hash state identifier")
+	}
+	if len(b) != marshaledSize {
+		return errors.New("stackadler32: invalid hash state size")
+	}
+	sum := binary.BigEndian.Uint32(b[4:8])
+	d.initialized = true
+	d.s1 = sum & 0xffff
+	d.s2 = sum >> 16
+	return nil
+}
+
+// MarshalBinary returns a binary representation of d's state, so a running
+// checksum can be saved and later resumed with UnmarshalBinary.
+func (d *digest) MarshalBinary() ([]byte, error) {
+	return d.d.MarshalBinary()
+}
+
+// UnmarshalBinary restores d's state from a representation produced by
+// MarshalBinary.
+func (d *digest) UnmarshalBinary(b []byte) error {
+	return d.d.UnmarshalBinary(b)
+}
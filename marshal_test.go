@@ -0,0 +1,97 @@
+package stackadler32
+
+import (
+	"encoding"
+	"hash/adler32"
+	"testing"
+)
+
+// TestMarshalRoundTrip verifies that MarshalBinary/UnmarshalBinary preserve
+// a Digest's checksum across a save/resume cycle, on both Digest and the
+// hash.Hash32 wrapper.
+func TestMarshalRoundTrip(t *testing.T) {
+	want := NewDigest().Update([]byte("hello, world"))
+
+	b, err := want.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+
+	var got Digest
+	if err := got.UnmarshalBinary(b); err != nil {
+		t.Fatalf("UnmarshalBinary: %v", err)
+	}
+	if got.Sum32() != want.Sum32() {
+		t.Errorf("Sum32 after round trip = %#x, want %#x", got.Sum32(), want.Sum32())
+	}
+
+	h := New()
+	h.Write([]byte("hello, world"))
+	hb, err := h.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		t.Fatalf("hash.Hash32 MarshalBinary: %v", err)
+	}
+
+	h2 := New()
+	if err := h2.(encoding.BinaryUnmarshaler).UnmarshalBinary(hb); err != nil {
+		t.Fatalf("hash.Hash32 UnmarshalBinary: %v", err)
+	}
+	if h2.Sum32() != h.Sum32() {
+		t.Errorf("hash.Hash32 Sum32 after round trip = %#x, want %#x", h2.Sum32(), h.Sum32())
+	}
+}
+
+// TestMarshalInterchangeableWithStdlib verifies that the encoding produced
+// by MarshalBinary can be consumed by hash/adler32's UnmarshalBinary, and
+// vice versa, since both packages use the same magic-prefixed, combined
+// big-endian checksum layout.
+func TestMarshalInterchangeableWithStdlib(t *testing.T) {
+	data := []byte("the quick brown fox")
+
+	ours := NewDigest().Update(data)
+	b, err := ours.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+
+	stdlib := adler32.New()
+	if err := stdlib.(encoding.BinaryUnmarshaler).UnmarshalBinary(b); err != nil {
+		t.Fatalf("hash/adler32 UnmarshalBinary(ours): %v", err)
+	}
+	if stdlib.Sum32() != ours.Sum32() {
+		t.Errorf("hash/adler32 decoded Sum32 = %#x, want %#x", stdlib.Sum32(), ours.Sum32())
+	}
+
+	stdlib2 := adler32.New()
+	stdlib2.Write(data)
+	sb, err := stdlib2.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		t.Fatalf("hash/adler32 MarshalBinary: %v", err)
+	}
+
+	var theirs Digest
+	if err := theirs.UnmarshalBinary(sb); err != nil {
+		t.Fatalf("UnmarshalBinary(stdlib): %v", err)
+	}
+	if theirs.Sum32() != stdlib2.Sum32() {
+		t.Errorf("decoded stdlib Sum32 = %#x, want %#x", theirs.Sum32(), stdlib2.Sum32())
+	}
+}
+
+// TestUnmarshalErrors verifies that UnmarshalBinary rejects malformed
+// state with a descriptive error instead of silently accepting it.
+func TestUnmarshalErrors(t *testing.T) {
+	var d Digest
+
+	if err := d.UnmarshalBinary([]byte("xyz\x01\x00\x00\x00\x00")); err == nil {
+		t.Error("UnmarshalBinary with wrong magic: got nil error, want one")
+	}
+
+	if err := d.UnmarshalBinary([]byte(magic)); err == nil {
+		t.Error("UnmarshalBinary with truncated state: got nil error, want one")
+	}
+
+	if err := d.UnmarshalBinary(append([]byte(magic), 0, 0, 0, 0, 0)); err == nil {
+		t.Error("UnmarshalBinary with trailing byte: got nil error, want one")
+	}
+}
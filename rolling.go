@@ -0,0 +1,78 @@
+package stackadler32
+
+// Rolling computes an Adler-32 checksum over a fixed-size sliding window,
+// as used by rsync-style delta algorithms. Once the window has been
+// primed, each call to Roll evicts the oldest byte and admits a new one
+// in O(1) time, independent of the window size.
+type Rolling struct {
+	window []byte // ring buffer holding the current window's contents
+	pos    int    // index of the oldest byte once the window is full
+	fill   int    // number of bytes collected so far, while priming
+	full   bool   // whether the window has been primed
+	d      Digest // accumulates the checksum while priming
+	s1, s2 uint32 // checksum components once the window is full
+}
+
+// NewRolling returns a Rolling checksum over a sliding window of the given
+// size. The window must be primed with exactly window bytes, fed one at a
+// time through Roll, before the returned checksum reflects a full window.
+// NewRolling panics if window is not positive.
+func NewRolling(window int) *Rolling {
+	if window <= 0 {
+		panic("stackadler32: window must be positive")
+	}
+	return &Rolling{
+		window: make([]byte, window),
+		d:      NewDigest(),
+	}
+}
+
+// Roll admits in as the newest byte of the window. Once the window has
+// been primed with window bytes, this also evicts the oldest byte and
+// returns the Adler-32 checksum of the resulting window in O(1) time.
+// Before priming completes, it returns the checksum of the bytes seen
+// so far.
+func (r *Rolling) Roll(in byte) uint32 {
+	if !r.full {
+		r.window[r.fill] = in
+		r.fill++
+		r.d = r.d.Update([]byte{in})
+		if r.fill == len(r.window) {
+			r.full = true
+			r.s1, r.s2 = r.d.s1, r.d.s2
+		}
+		return r.d.Sum32()
+	}
+
+	n := uint32(len(r.window))
+	out := r.window[r.pos]
+	r.window[r.pos] = in
+	r.pos++
+	if r.pos == len(r.window) {
+		r.pos = 0
+	}
+
+	s1 := (r.s1 + uint32(in) + prime - uint32(out)) % prime
+	nOut := (n % prime) * uint32(out) % prime
+	s2 := (r.s2 + s1 + 2*prime - nOut - 1) % prime
+
+	r.s1, r.s2 = s1, s2
+	return (s2 << 16) | s1
+}
+
+// Sum32 returns the Adler-32 checksum of the current window.
+func (r *Rolling) Sum32() uint32 {
+	if !r.full {
+		return r.d.Sum32()
+	}
+	return (r.s2 << 16) | r.s1
+}
+
+// Reset clears the window and returns r to its initial, unprimed state.
+func (r *Rolling) Reset() {
+	r.pos = 0
+	r.fill = 0
+	r.full = false
+	r.d = NewDigest()
+	r.s1, r.s2 = 0, 0
+}
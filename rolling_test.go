@@ -0,0 +1,67 @@
+package stackadler32
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+// TestRolling cross-checks every value produced by Roll, once the window
+// is full, against Checksum recomputed from scratch over the same window.
+func TestRolling(t *testing.T) {
+	const window = 16
+	data := make([]byte, 200)
+	rand.New(rand.NewSource(1)).Read(data)
+
+	r := NewRolling(window)
+	for i, b := range data {
+		got := r.Roll(b)
+
+		start := i + 1 - window
+		if start < 0 {
+			continue // window not yet full
+		}
+		want := Checksum(data[start : i+1])
+		if got != want {
+			t.Fatalf("Roll at byte %d = %#x, want %#x", i, got, want)
+		}
+		if sum := r.Sum32(); sum != want {
+			t.Fatalf("Sum32 at byte %d = %#x, want %#x", i, sum, want)
+		}
+	}
+}
+
+// TestNewRollingInvalidWindow verifies that NewRolling panics on a
+// non-positive window instead of deferring the failure to a later,
+// harder-to-diagnose index panic inside Roll.
+func TestNewRollingInvalidWindow(t *testing.T) {
+	for _, window := range []int{0, -1} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewRolling(%d): expected panic, got none", window)
+				}
+			}()
+			NewRolling(window)
+		}()
+	}
+}
+
+func BenchmarkRoll(b *testing.B) {
+	for _, window := range []int{16, 256, 4096} {
+		b.Run(fmt.Sprintf("window=%d", window), func(b *testing.B) {
+			r := NewRolling(window)
+			data := make([]byte, window)
+			rand.New(rand.NewSource(1)).Read(data)
+			for _, c := range data {
+				r.Roll(c)
+			}
+
+			b.SetBytes(1)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				r.Roll(byte(i))
+			}
+		})
+	}
+}